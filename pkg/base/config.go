@@ -21,6 +21,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/envutil"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
 	"github.com/cockroachdb/cockroach/pkg/util/retry"
+	"github.com/cockroachdb/errors"
 )
 
 // Base config defaults.
@@ -51,9 +52,6 @@ const (
 	// DefaultCertsDirectory is the default value for the cert directory flag.
 	DefaultCertsDirectory = "${HOME}/.cockroach-certs"
 
-	// defaultRaftTickInterval is the default resolution of the Raft timer.
-	defaultRaftTickInterval = 200 * time.Millisecond
-
 	// defaultRangeLeaseRaftElectionTimeoutMultiplier specifies what multiple the
 	// leader lease active duration should be of the raft election timeout.
 	defaultRangeLeaseRaftElectionTimeoutMultiplier = 3
@@ -62,11 +60,6 @@ const (
 	// See https://github.com/cockroachdb/cockroach/issues/20310.
 	DefaultMetricsSampleInterval = 10 * time.Second
 
-	// defaultRaftHeartbeatIntervalTicks is the default value for
-	// RaftHeartbeatIntervalTicks, which determines the number of ticks between
-	// each heartbeat.
-	defaultRaftHeartbeatIntervalTicks = 5
-
 	// defaultRPCHeartbeatInterval is the default value of RPCHeartbeatInterval
 	// used by the rpc context.
 	defaultRPCHeartbeatInterval = 3 * time.Second
@@ -116,6 +109,22 @@ func DefaultHistogramWindowInterval() time.Duration {
 }
 
 var (
+	// defaultRaftTickInterval is the default resolution of the Raft timer.
+	//
+	// The tick interval, heartbeat interval and election timeout determine how
+	// quickly failures are detected, and also the range lease duration (see
+	// RangeLeaseActiveDuration). Deployments with high inter-node latencies
+	// may want to trade slower failover for fewer spurious elections by
+	// raising them. All nodes in a cluster must use the same values.
+	defaultRaftTickInterval = envutil.EnvOrDefaultDuration(
+		"COCKROACH_RAFT_TICK_INTERVAL", 200*time.Millisecond)
+
+	// defaultRaftHeartbeatIntervalTicks is the default value for
+	// RaftHeartbeatIntervalTicks, which determines the number of ticks between
+	// each heartbeat.
+	defaultRaftHeartbeatIntervalTicks = envutil.EnvOrDefaultInt(
+		"COCKROACH_RAFT_HEARTBEAT_INTERVAL_TICKS", 5)
+
 	// defaultRaftElectionTimeoutTicks specifies the number of Raft Tick
 	// invocations that must pass between elections.
 	defaultRaftElectionTimeoutTicks = envutil.EnvOrDefaultInt(
@@ -431,6 +440,26 @@ func (cfg *RaftConfig) SetDefaults() {
 	}
 }
 
+// Validate returns an error if the Raft timing parameters, which can be
+// overridden through environment variables, are not usable.
+func (cfg RaftConfig) Validate() error {
+	if cfg.RaftTickInterval <= 0 {
+		return errors.Errorf("raft tick interval must be positive, got %s "+
+			"(see COCKROACH_RAFT_TICK_INTERVAL)", cfg.RaftTickInterval)
+	}
+	if cfg.RaftHeartbeatIntervalTicks <= 0 {
+		return errors.Errorf("raft heartbeat interval ticks must be positive, got %d "+
+			"(see COCKROACH_RAFT_HEARTBEAT_INTERVAL_TICKS)", cfg.RaftHeartbeatIntervalTicks)
+	}
+	if cfg.RaftElectionTimeoutTicks <= cfg.RaftHeartbeatIntervalTicks {
+		return errors.Errorf("raft election timeout ticks (%d) must be greater than "+
+			"raft heartbeat interval ticks (%d) (see COCKROACH_RAFT_ELECTION_TIMEOUT_TICKS "+
+			"and COCKROACH_RAFT_HEARTBEAT_INTERVAL_TICKS)",
+			cfg.RaftElectionTimeoutTicks, cfg.RaftHeartbeatIntervalTicks)
+	}
+	return nil
+}
+
 // RaftElectionTimeout returns the raft election timeout, as computed from the
 // tick interval and number of election timeout ticks.
 func (cfg RaftConfig) RaftElectionTimeout() time.Duration {
@@ -0,0 +1,55 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRaftConfigValidate verifies that unusable Raft timing parameters are
+// rejected.
+func TestRaftConfigValidate(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	testCases := []struct {
+		tickInterval   time.Duration
+		heartbeatTicks int
+		electionTicks  int
+		expErr         string
+	}{
+		{0, 0, 0, ""}, // defaults
+		{time.Second, 2, 10, ""},
+		{-time.Second, 0, 0, "raft tick interval must be positive"},
+		{0, -1, 0, "raft heartbeat interval ticks must be positive"},
+		{0, 15, 15, "must be greater than raft heartbeat interval ticks"},
+		{0, 10, 5, "must be greater than raft heartbeat interval ticks"},
+	}
+	for _, tc := range testCases {
+		cfg := base.RaftConfig{
+			RaftTickInterval:           tc.tickInterval,
+			RaftHeartbeatIntervalTicks: tc.heartbeatTicks,
+			RaftElectionTimeoutTicks:   tc.electionTicks,
+		}
+		cfg.SetDefaults()
+		err := cfg.Validate()
+		if tc.expErr == "" {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expErr)
+		}
+	}
+}
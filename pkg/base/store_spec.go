@@ -185,6 +185,11 @@ type StoreSpec struct {
 	// Pebble OPTIONS file but treating any whitespace as a newline:
 	// (Eg, "[Options] delete_range_flush_delay=2s flush_split_bytes=4096")
 	PebbleOptions string
+	// WALDir, if set, is the directory in which the store's write-ahead log is
+	// kept instead of Path. This allows placing the WAL on a separate, lower
+	// latency device. The same WALDir must be used every time the store is
+	// opened, or writes which have not yet been flushed will be missing.
+	WALDir string
 	// EncryptionOptions is a serialized protobuf set by Go CCL code and passed
 	// through to C CCL code to set up encryption-at-rest.  Must be set if and
 	// only if encryption is enabled, otherwise left empty.
@@ -225,6 +230,9 @@ func (ss StoreSpec) String() string {
 		}
 		fmt.Fprintf(&buffer, ",")
 	}
+	if len(ss.WALDir) != 0 {
+		fmt.Fprintf(&buffer, "wal-dir=%s,", ss.WALDir)
+	}
 	if len(ss.PebbleOptions) > 0 {
 		optsStr := strings.Replace(ss.PebbleOptions, "\n", " ", -1)
 		fmt.Fprint(&buffer, "pebble=")
@@ -361,6 +369,12 @@ func NewStoreSpec(value string) (StoreSpec, error) {
 			} else {
 				return StoreSpec{}, fmt.Errorf("%s is not a valid store type", value)
 			}
+		case "wal-dir":
+			var err error
+			ss.WALDir, err = GetAbsoluteStorePath("wal-dir", value)
+			if err != nil {
+				return StoreSpec{}, err
+			}
 		case "rocksdb":
 			ss.RocksDBOptions = value
 		case "pebble":
@@ -414,6 +428,9 @@ func NewStoreSpec(value string) (StoreSpec, error) {
 		if ss.BallastSize != nil {
 			return StoreSpec{}, fmt.Errorf("ballast-size specified for in memory store")
 		}
+		if ss.WALDir != "" {
+			return StoreSpec{}, fmt.Errorf("wal-dir specified for in memory store")
+		}
 	} else if ss.Path == "" {
 		return StoreSpec{}, fmt.Errorf("no path specified")
 	}
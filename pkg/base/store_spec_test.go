@@ -151,6 +151,11 @@ target_file_size=2097152`
 		{"path=/mnt/hda1,type=other", "other is not a valid store type", StoreSpec{}},
 		{"path=/mnt/hda1,type=mem,size=20GiB", "path specified for in memory store", StoreSpec{}},
 
+		// wal-dir
+		{"path=/mnt/hda1,wal-dir=/mnt/ssd01/wal", "", StoreSpec{Path: "/mnt/hda1", WALDir: "/mnt/ssd01/wal"}},
+		{"path=/mnt/hda1,wal-dir=~/wal", "wal-dir cannot start with '~': ~/wal", StoreSpec{}},
+		{"type=mem,size=20GiB,wal-dir=/mnt/ssd01/wal", "wal-dir specified for in memory store", StoreSpec{}},
+
 		// RocksDB
 		{"path=/,rocksdb=key1=val1;key2=val2", "", StoreSpec{Path: "/", RocksDBOptions: "key1=val1;key2=val2"}},
 
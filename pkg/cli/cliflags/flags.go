@@ -883,6 +883,17 @@ for example:
   --store=path=/mnt/ssd01,size=.2              -> 20% of available space
 
 </PRE>
+The "wal-dir" field places the store's write-ahead log in a separate directory,
+for example on a lower latency device:
+<PRE>
+
+  --store=path=/mnt/hda1,wal-dir=/mnt/nvme01/wal
+
+</PRE>
+The same "wal-dir" must be specified every time the store is opened, and it
+must not be the "path" or "wal-dir" of another store. The "cockroach debug"
+commands which open a store directly do not support a separate WAL directory
+and must not be used on such a store.
 For an in-memory store, the "type" and "size" fields are required, and the
 "path" field is forbidden. The "type" field must be set to "mem", and the
 "size" field must be set to the true maximum bytes or percentage of available
@@ -72,6 +72,15 @@ type consistencyShouldQueueData struct {
 	interval                  time.Duration
 }
 
+// consistencyQueueEnabled controls whether the consistency checker queue is
+// enabled.
+var consistencyQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.consistency_queue.enabled",
+	"whether the consistency checker queue is enabled",
+	true,
+)
+
 // newConsistencyQueue returns a new instance of consistencyQueue.
 func newConsistencyQueue(store *Store) *consistencyQueue {
 	q := &consistencyQueue{
@@ -87,6 +96,7 @@ func newConsistencyQueue(store *Store) *consistencyQueue {
 			needsLease:           true,
 			needsSystemConfig:    false,
 			acceptsUnsplitRanges: true,
+			enabledSetting:       consistencyQueueEnabled,
 			successes:            store.metrics.ConsistencyQueueSuccesses,
 			failures:             store.metrics.ConsistencyQueueFailures,
 			pending:              store.metrics.ConsistencyQueuePending,
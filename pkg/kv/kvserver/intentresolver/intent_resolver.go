@@ -16,6 +16,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/cockroachdb/cockroach/pkg/base"
 	"github.com/cockroachdb/cockroach/pkg/internal/client/requestbatcher"
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/kv"
@@ -24,13 +25,18 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverbase"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/txnwait"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
 	"github.com/cockroachdb/cockroach/pkg/util/contextutil"
+	"github.com/cockroachdb/cockroach/pkg/util/ctxgroup"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/quotapool"
+	"github.com/cockroachdb/cockroach/pkg/util/retry"
 	"github.com/cockroachdb/cockroach/pkg/util/stop"
 	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
 	"github.com/cockroachdb/cockroach/pkg/util/uuid"
 	"github.com/cockroachdb/errors"
 )
@@ -43,11 +49,15 @@ const (
 	// TODO(bdarnell): how to determine best value?
 	defaultTaskLimit = 1000
 
-	// asyncIntentResolutionTimeout is the timeout when processing a group of
-	// intents asynchronously. The timeout prevents async intent resolution from
-	// getting stuck. Since processing intents is best effort, we'd rather give
-	// up than wait too long (this helps avoid deadlocks during test shutdown).
-	asyncIntentResolutionTimeout = 30 * time.Second
+	// asyncIntentResolutionTimeoutPerIntent is the amount by which the timeout
+	// for processing a group of intents asynchronously is extended for each
+	// intent in the group. See asyncIntentResolutionTimeout.
+	asyncIntentResolutionTimeoutPerIntent = 10 * time.Millisecond
+
+	// maxAsyncIntentResolutionTimeoutExtension bounds the total amount by which
+	// the timeout for processing a group of intents asynchronously is extended,
+	// so that a single large group cannot hold an async task slot for hours.
+	maxAsyncIntentResolutionTimeoutExtension = 5 * time.Minute
 
 	// gcBatchSize is the maximum number of transaction records that will be
 	// GCed in a single batch. Batches that span many ranges (which is possible
@@ -73,6 +83,13 @@ const (
 	// ResumeSpan and the batcher will send a new range request.
 	intentResolverRangeRequestSize = 200
 
+	// intentResolverBatchByteSize is the maximum size in bytes of the
+	// requests in a single intent resolution batch. The message count limits
+	// above do not bound the size of a batch whose requests carry large keys
+	// or long IgnoredSeqNums lists, so this limit keeps the resulting Raft
+	// commands from growing unboundedly.
+	intentResolverBatchByteSize = 4 << 20 // 4 MB
+
 	// MaxTxnsPerIntentCleanupBatch is the number of transactions whose
 	// corresponding intents will be resolved at a time. Intents are batched
 	// by transaction to avoid timeouts while resolving intents and ensure that
@@ -105,9 +122,39 @@ const (
 	gcTxnRecordTimeout = 20 * time.Second
 )
 
-// Config contains the dependencies to construct an IntentResolver.
+// asyncIntentResolutionTimeout is the base timeout when processing a group of
+// intents asynchronously. The timeout prevents async intent resolution from
+// getting stuck. Since processing intents is best effort, we'd rather give up
+// than wait too long (this helps avoid deadlocks during test shutdown). The
+// timeout is extended by asyncIntentResolutionTimeoutPerIntent for each intent
+// in the group, up to maxAsyncIntentResolutionTimeoutExtension, so that large
+// groups spanning many ranges can make progress.
+var asyncIntentResolutionTimeout = settings.RegisterDurationSetting(
+	settings.SystemOnly,
+	"kv.intent_resolver.async_resolution.timeout",
+	"the base timeout for asynchronously resolving a group of intents; the "+
+		"timeout is extended for each intent in the group",
+	30*time.Second,
+	settings.PositiveDuration,
+)
+
+// asyncIntentResolutionRetryOptions are the options used to retry a failed
+// attempt to process a group of intents asynchronously. Intents whose
+// resolution is abandoned linger until they are encountered again by another
+// request or by the MVCC GC queue, so transient failures are worth retrying.
+// See isRetryableAsyncCleanupError.
+var asyncIntentResolutionRetryOptions = retry.Options{
+	InitialBackoff: 50 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	Multiplier:     2,
+	MaxRetries:     2,
+}
+
+// Config contains the dependencies to construct an IntentResolver. Settings
+// is required.
 type Config struct {
 	Clock                *hlc.Clock
+	Settings             *cluster.Settings
 	DB                   *kv.DB
 	Stopper              *stop.Stopper
 	AmbientCtx           log.AmbientContext
@@ -119,6 +166,9 @@ type Config struct {
 	MaxGCBatchIdle               time.Duration
 	MaxIntentResolutionBatchWait time.Duration
 	MaxIntentResolutionBatchIdle time.Duration
+
+	// HistogramWindowInterval is (server.Config).HistogramWindowInterval.
+	HistogramWindowInterval time.Duration
 }
 
 // RangeCache is a simplified interface to the rngcache.RangeCache.
@@ -133,6 +183,7 @@ type IntentResolver struct {
 	Metrics Metrics
 
 	clock        *hlc.Clock
+	settings     *cluster.Settings
 	db           *kv.DB
 	stopper      *stop.Stopper
 	testingKnobs kvserverbase.IntentResolverTestingKnobs
@@ -180,6 +231,9 @@ func setConfigDefaults(c *Config) {
 	if c.RangeDescriptorCache == nil {
 		c.RangeDescriptorCache = nopRangeDescriptorCache{}
 	}
+	if c.HistogramWindowInterval == 0 {
+		c.HistogramWindowInterval = base.DefaultHistogramWindowInterval()
+	}
 }
 
 type nopRangeDescriptorCache struct{}
@@ -192,14 +246,18 @@ func (nrdc nopRangeDescriptorCache) Lookup(
 
 // New creates an new IntentResolver.
 func New(c Config) *IntentResolver {
+	if c.Settings == nil {
+		panic(errors.AssertionFailedf("intent resolver requires cluster settings"))
+	}
 	setConfigDefaults(&c)
 	ir := &IntentResolver{
 		clock:        c.Clock,
+		settings:     c.Settings,
 		db:           c.DB,
 		stopper:      c.Stopper,
 		sem:          quotapool.NewIntPool("intent resolver", uint64(c.TaskLimit)),
 		every:        log.Every(time.Minute),
-		Metrics:      makeMetrics(),
+		Metrics:      makeMetrics(c.HistogramWindowInterval),
 		rdc:          c.RangeDescriptorCache,
 		testingKnobs: c.TestingKnobs,
 	}
@@ -207,7 +265,7 @@ func New(c Config) *IntentResolver {
 	ir.mu.inFlightPushes = map[uuid.UUID]int{}
 	ir.mu.inFlightTxnCleanups = map[uuid.UUID]struct{}{}
 	gcBatchSize := gcBatchSize
-	if c.TestingKnobs.MaxIntentResolutionBatchSize > 0 {
+	if c.TestingKnobs.MaxGCBatchSize > 0 {
 		gcBatchSize = c.TestingKnobs.MaxGCBatchSize
 	}
 	ir.gcBatcher = requestbatcher.New(requestbatcher.Config{
@@ -225,10 +283,15 @@ func New(c Config) *IntentResolver {
 		intentResolutionBatchSize = c.TestingKnobs.MaxIntentResolutionBatchSize
 		intentResolutionRangeBatchSize = c.TestingKnobs.MaxIntentResolutionBatchSize
 	}
+	intentResolutionBatchByteSize := intentResolverBatchByteSize
+	if c.TestingKnobs.MaxIntentResolutionBatchBytes > 0 {
+		intentResolutionBatchByteSize = c.TestingKnobs.MaxIntentResolutionBatchBytes
+	}
 	ir.irBatcher = requestbatcher.New(requestbatcher.Config{
 		AmbientCtx:      c.AmbientCtx,
 		Name:            "intent_resolver_ir_batcher",
 		MaxMsgsPerBatch: intentResolutionBatchSize,
+		MaxSizePerBatch: intentResolutionBatchByteSize,
 		MaxWait:         c.MaxIntentResolutionBatchWait,
 		MaxIdle:         c.MaxIntentResolutionBatchIdle,
 		Stopper:         c.Stopper,
@@ -238,6 +301,7 @@ func New(c Config) *IntentResolver {
 		AmbientCtx:         c.AmbientCtx,
 		Name:               "intent_resolver_ir_range_batcher",
 		MaxMsgsPerBatch:    intentResolutionRangeBatchSize,
+		MaxSizePerBatch:    intentResolutionBatchByteSize,
 		MaxKeysPerBatchReq: intentResolverRangeRequestSize,
 		MaxWait:            c.MaxIntentResolutionBatchWait,
 		MaxIdle:            c.MaxIntentResolutionBatchIdle,
@@ -337,6 +401,32 @@ func (ir *IntentResolver) MaybePushTransactions(
 	pushType roachpb.PushTxnType,
 	skipIfInFlight bool,
 ) (map[uuid.UUID]*roachpb.Transaction, *roachpb.Error) {
+	pushedTxns, _, pErr := ir.maybePushTransactions(ctx, pushTxns, h, pushType, skipIfInFlight)
+	if pErr != nil {
+		ir.recordFailedPushes(len(pushTxns))
+	}
+	return pushedTxns, pErr
+}
+
+// recordFailedPushes records that the pushes of numTxns transactions were
+// attempted and failed.
+func (ir *IntentResolver) recordFailedPushes(numTxns int) {
+	ir.Metrics.PushesAttempted.Inc(int64(numTxns))
+	ir.Metrics.PushesFailed.Inc(int64(numTxns))
+}
+
+// maybePushTransactions is like MaybePushTransactions, but when the push
+// fails and the error can be attributed to the PushTxn request of a single
+// pushee, it also returns the ID of that pushee. Otherwise, failedTxnID is
+// uuid.Nil. Failed pushes are not recorded in the metrics, since the caller
+// may decide to push some of the transactions again.
+func (ir *IntentResolver) maybePushTransactions(
+	ctx context.Context,
+	pushTxns map[uuid.UUID]*enginepb.TxnMeta,
+	h roachpb.Header,
+	pushType roachpb.PushTxnType,
+	skipIfInFlight bool,
+) (_ map[uuid.UUID]*roachpb.Transaction, failedTxnID uuid.UUID, _ *roachpb.Error) {
 	// Decide which transactions to push and which to ignore because
 	// of other in-flight requests. For those transactions that we
 	// will be pushing, increment their ref count in the in-flight
@@ -367,7 +457,7 @@ func (ir *IntentResolver) MaybePushTransactions(
 	}
 	ir.mu.Unlock()
 	if len(pushTxns) == 0 {
-		return nil, nil
+		return nil, uuid.Nil, nil
 	}
 
 	pusherTxn := getPusherTxn(h)
@@ -378,7 +468,9 @@ func (ir *IntentResolver) MaybePushTransactions(
 	b := &kv.Batch{}
 	b.Header.Timestamp = ir.clock.Now()
 	b.Header.Timestamp.Forward(pushTo)
+	pushees := make([]uuid.UUID, 0, len(pushTxns))
 	for _, pushTxn := range pushTxns {
+		pushees = append(pushees, pushTxn.ID)
 		b.AddRawRequest(&roachpb.PushTxnRequest{
 			RequestHeader: roachpb.RequestHeader{
 				Key: pushTxn.Key,
@@ -392,8 +484,14 @@ func (ir *IntentResolver) MaybePushTransactions(
 	err := ir.db.Run(ctx, b)
 	cleanupInFlightPushes()
 	if err != nil {
-		return nil, b.MustPErr()
+		pErr := b.MustPErr()
+		if pErr.Index != nil && pErr.Index.Index >= 0 && int(pErr.Index.Index) < len(pushees) {
+			failedTxnID = pushees[pErr.Index.Index]
+		}
+		return nil, failedTxnID, pErr
 	}
+	ir.Metrics.PushesAttempted.Inc(int64(len(pushTxns)))
+	ir.Metrics.PushesSucceeded.Inc(int64(len(pushTxns)))
 
 	// TODO(nvanbenschoten): if we succeed because the transaction has already
 	// been pushed _past_ where we were pushing, we need to set the synthetic
@@ -409,15 +507,16 @@ func (ir *IntentResolver) MaybePushTransactions(
 		pushedTxns[txn.ID] = txn
 		log.Eventf(ctx, "%s is now %s", txn.ID, txn.Status)
 	}
-	return pushedTxns, nil
+	return pushedTxns, uuid.Nil, nil
 }
 
 // runAsyncTask semi-synchronously runs a generic task function. If
 // there is spare capacity in the limited async task semaphore, it's
 // run asynchronously; otherwise, it's run synchronously if
-// allowSyncProcessing is true; if false, an error is returned.
+// allowSyncProcessing is true; if false, an error is returned. taskFn is
+// told whether it runs asynchronously.
 func (ir *IntentResolver) runAsyncTask(
-	ctx context.Context, allowSyncProcessing bool, taskFn func(context.Context),
+	ctx context.Context, allowSyncProcessing bool, taskFn func(ctx context.Context, async bool),
 ) error {
 	if ir.testingKnobs.DisableAsyncIntentResolution {
 		return errors.New("intents not processed as async resolution is disabled")
@@ -431,7 +530,9 @@ func (ir *IntentResolver) runAsyncTask(
 			Sem:        ir.sem,
 			WaitForSem: false,
 		},
-		taskFn,
+		ir.trackAsyncTask(func(ctx context.Context) {
+			taskFn(ctx, true /* async */)
+		}),
 	)
 	if err != nil {
 		if errors.Is(err, stop.ErrThrottled) {
@@ -439,7 +540,7 @@ func (ir *IntentResolver) runAsyncTask(
 			if allowSyncProcessing {
 				// A limited task was not available. Rather than waiting for
 				// one, we reuse the current goroutine.
-				taskFn(ctx)
+				taskFn(ctx, false /* async */)
 				return nil
 			}
 		}
@@ -448,6 +549,16 @@ func (ir *IntentResolver) runAsyncTask(
 	return nil
 }
 
+// trackAsyncTask wraps taskFn, an asynchronous task, so that it is accounted
+// for in the IntentResolverAsyncRunning gauge until it completes.
+func (ir *IntentResolver) trackAsyncTask(taskFn func(context.Context)) func(context.Context) {
+	return func(ctx context.Context) {
+		ir.Metrics.IntentResolverAsyncRunning.Inc(1)
+		defer ir.Metrics.IntentResolverAsyncRunning.Dec(1)
+		taskFn(ctx)
+	}
+}
+
 // CleanupIntentsAsync asynchronously processes intents which were
 // encountered during another command but did not interfere with the
 // execution of that command. This occurs during inconsistent
@@ -459,27 +570,84 @@ func (ir *IntentResolver) CleanupIntentsAsync(
 		return nil
 	}
 	now := ir.clock.Now()
-	return ir.runAsyncTask(ctx, allowSyncProcessing, func(ctx context.Context) {
+	return ir.runAsyncTask(ctx, allowSyncProcessing, func(ctx context.Context, async bool) {
+		var resolved int
+		cleanup := func(ctx context.Context) (err error) {
+			resolved, _, err = ir.CleanupIntents(ctx, intents, now, roachpb.PUSH_TOUCH)
+			return err
+		}
 		err := contextutil.RunWithTimeout(ctx, "async intent resolution",
-			asyncIntentResolutionTimeout, func(ctx context.Context) error {
-				_, err := ir.CleanupIntents(ctx, intents, now, roachpb.PUSH_TOUCH)
-				return err
+			ir.asyncResolutionTimeout(len(intents)), func(ctx context.Context) error {
+				return retryAsyncCleanup(ctx, async, cleanup)
 			})
-		if err != nil && ir.every.ShouldLog() {
-			log.Warningf(ctx, "%v", err)
+		if async {
+			ir.Metrics.IntentsResolvedAsync.Inc(int64(resolved))
+		}
+		// Failing to push a live transaction is the expected outcome of a
+		// PUSH_TOUCH, so it is not a failure to clean up the intents.
+		if err != nil && !errors.HasType(err, (*roachpb.TransactionPushError)(nil)) {
+			if async {
+				ir.Metrics.IntentResolverAsyncGaveUp.Inc(1)
+			}
+			if ir.every.ShouldLog() {
+				log.Warningf(ctx, "%v", err)
+			}
 		}
 	})
 }
 
+// retryAsyncCleanup runs cleanup, an attempt to process a group of intents.
+// When run by an asynchronous task, a failed attempt is retried with backoff
+// as long as the failure is transient. Attempts run synchronously are not
+// retried, so as not to delay the foreground request whose goroutine they run
+// on.
+func retryAsyncCleanup(ctx context.Context, async bool, cleanup func(context.Context) error) error {
+	if !async {
+		return cleanup(ctx)
+	}
+	var err error
+	for r := retry.StartWithCtx(ctx, asyncIntentResolutionRetryOptions); r.Next(); {
+		if err = cleanup(ctx); err == nil || !isRetryableAsyncCleanupError(err) {
+			break
+		}
+	}
+	return err
+}
+
+// isRetryableAsyncCleanupError returns whether a failed attempt to process a
+// group of intents asynchronously may succeed when retried. Only errors
+// signaling the transient unavailability of a node or range are retried. In
+// particular, a TransactionPushError is not: it is returned when PUSH_TOUCH
+// finds a live pushee, whose intents cannot be resolved until it finishes.
+func isRetryableAsyncCleanupError(err error) bool {
+	return errors.HasType(err, (*roachpb.NodeUnavailableError)(nil)) ||
+		errors.HasType(err, (*roachpb.ReplicaUnavailableError)(nil)) ||
+		errors.HasType(err, (*roachpb.AmbiguousResultError)(nil))
+}
+
+// asyncResolutionTimeout returns the timeout for asynchronously processing a
+// group of numIntents intents.
+func (ir *IntentResolver) asyncResolutionTimeout(numIntents int) time.Duration {
+	timeout := asyncIntentResolutionTimeout.Get(&ir.settings.SV)
+	extension := time.Duration(numIntents) * asyncIntentResolutionTimeoutPerIntent
+	if extension > maxAsyncIntentResolutionTimeoutExtension {
+		extension = maxAsyncIntentResolutionTimeoutExtension
+	}
+	return timeout + extension
+}
+
 // CleanupIntents processes a collection of intents by pushing each
 // implicated transaction using the specified pushType. Intents
 // belonging to non-pending transactions after the push are resolved.
-// On success, returns the number of resolved intents. On error, a
-// subset of the intents may have been resolved, but zero will be
-// returned.
+// Returns the number of resolved intents and the number of intents that could
+// not be resolved because the push of their transaction or their resolution
+// failed. Intents of transactions that were not pushed because another push
+// was already in flight are counted in neither. A failure to push some of the
+// transactions does not prevent the intents of the others from being
+// resolved; in that case these numbers are returned along with the error.
 func (ir *IntentResolver) CleanupIntents(
 	ctx context.Context, intents []roachpb.Intent, now hlc.Timestamp, pushType roachpb.PushTxnType,
-) (int, error) {
+) (resolved, failed int, _ error) {
 	h := roachpb.Header{Timestamp: now}
 
 	// All transactions in MaybePushTransactions will be sent in a single batch.
@@ -490,7 +658,8 @@ func (ir *IntentResolver) CleanupIntents(
 	// batch that times out has no effect. Hence, we chunk the work to ensure
 	// progress even when a timeout is eventually hit.
 	sort.Sort(intentsByTxn(intents))
-	resolved := 0
+	var skipped int
+	var pushErr error
 	const skipIfInFlight = true
 	pushTxns := make(map[uuid.UUID]*enginepb.TxnMeta)
 	var resolveIntents []roachpb.LockUpdate
@@ -510,9 +679,22 @@ func (ir *IntentResolver) CleanupIntents(
 			}
 		}
 
-		pushedTxns, pErr := ir.MaybePushTransactions(ctx, pushTxns, h, pushType, skipIfInFlight)
-		if pErr != nil {
-			return 0, errors.Wrapf(pErr.GoError(), "failed to push during intent resolution")
+		pushedTxns, err := ir.pushTransactionsDroppingFailures(ctx, pushTxns, h, pushType, skipIfInFlight)
+		if err != nil {
+			if ctx.Err() != nil {
+				return resolved, len(intents) - resolved - skipped,
+					errors.Wrapf(err, "failed to push during intent resolution")
+			}
+			if pushErr == nil {
+				pushErr = err
+			}
+		}
+		// The transactions that were skipped because of an in-flight push
+		// were removed from pushTxns.
+		for _, intent := range unpushed[:i] {
+			if _, ok := pushTxns[intent.Txn.ID]; !ok {
+				skipped++
+			}
 		}
 		resolveIntents = updateIntentTxnStatus(ctx, pushedTxns, unpushed[:i],
 			skipIfInFlight, resolveIntents[:0])
@@ -537,12 +719,81 @@ func (ir *IntentResolver) CleanupIntents(
 		// Thus, we must poison.
 		opts := ResolveOptions{Poison: true}
 		if pErr := ir.ResolveIntents(ctx, resolveIntents, opts); pErr != nil {
-			return 0, errors.Wrapf(pErr.GoError(), "failed to resolve intents")
+			return resolved, len(intents) - resolved - skipped,
+				errors.Wrapf(pErr.GoError(), "failed to resolve intents")
 		}
 		resolved += len(resolveIntents)
 		unpushed = unpushed[i:]
 	}
-	return resolved, nil
+	if pushErr != nil {
+		return resolved, len(intents) - resolved - skipped,
+			errors.Wrapf(pushErr, "failed to push during intent resolution")
+	}
+	return resolved, 0, nil
+}
+
+// pushTransactionsDroppingFailures pushes the given transactions like
+// MaybePushTransactions. The transactions are first pushed in a single batch,
+// so a single pushee that cannot be pushed (e.g. because it is still live and
+// pushType is PUSH_TOUCH) fails the push of all of them. When the failure can
+// be attributed to a single pushee, each of the other transactions is then
+// pushed on its own, in parallel, so that the intents of those that can be
+// pushed can still be resolved. This bounds the cost of a failed batch to one
+// more PushTxn request per transaction, however many of them cannot be
+// pushed. Transactions that are not pushed because another push is already in
+// flight are removed from pushTxns. It returns the transactions that were
+// pushed along with the first error encountered, if any.
+func (ir *IntentResolver) pushTransactionsDroppingFailures(
+	ctx context.Context,
+	pushTxns map[uuid.UUID]*enginepb.TxnMeta,
+	h roachpb.Header,
+	pushType roachpb.PushTxnType,
+	skipIfInFlight bool,
+) (map[uuid.UUID]*roachpb.Transaction, error) {
+	pushedTxns, failedTxnID, pErr := ir.maybePushTransactions(ctx, pushTxns, h, pushType, skipIfInFlight)
+	if pErr == nil {
+		return pushedTxns, nil
+	}
+	if failedTxnID == uuid.Nil || len(pushTxns) <= 1 || ctx.Err() != nil {
+		ir.recordFailedPushes(len(pushTxns))
+		return nil, pErr.GoError()
+	}
+	ir.recordFailedPushes(1)
+
+	var mu struct {
+		syncutil.Mutex
+		pushedTxns map[uuid.UUID]*roachpb.Transaction
+		skipped    []uuid.UUID
+	}
+	mu.pushedTxns = make(map[uuid.UUID]*roachpb.Transaction, len(pushTxns)-1)
+	g := ctxgroup.WithContext(ctx)
+	for txnID, pushTxn := range pushTxns {
+		if txnID == failedTxnID {
+			continue
+		}
+		txnID, pushTxn := txnID, pushTxn // copy for goroutine
+		g.GoCtx(func(ctx context.Context) error {
+			singlePushTxn := map[uuid.UUID]*enginepb.TxnMeta{txnID: pushTxn}
+			// The failed pushes are recorded in the metrics and otherwise
+			// superseded by the error of the batch.
+			pushed, _ := ir.MaybePushTransactions(ctx, singlePushTxn, h, pushType, skipIfInFlight)
+			mu.Lock()
+			defer mu.Unlock()
+			if len(singlePushTxn) == 0 {
+				mu.skipped = append(mu.skipped, txnID)
+			}
+			for id, txn := range pushed {
+				mu.pushedTxns[id] = txn
+			}
+			return nil
+		})
+	}
+	// The goroutines never return an error.
+	_ = g.Wait()
+	for _, txnID := range mu.skipped {
+		delete(pushTxns, txnID)
+	}
+	return mu.pushedTxns, pErr.GoError()
 }
 
 // CleanupTxnIntentsAsync asynchronously cleans up intents owned by a
@@ -568,14 +819,14 @@ func (ir *IntentResolver) CleanupTxnIntentsAsync(
 			}
 		}
 		et := &endTxns[i] // copy for goroutine
-		if err := ir.runAsyncTask(ctx, allowSyncProcessing, func(ctx context.Context) {
+		if err := ir.runAsyncTask(ctx, allowSyncProcessing, func(ctx context.Context, async bool) {
 			locked, release := ir.lockInFlightTxnCleanup(ctx, et.Txn.ID)
 			if !locked {
 				return
 			}
 			defer release()
 			if err := ir.cleanupFinishedTxnIntents(
-				ctx, rangeID, et.Txn, et.Poison, onComplete,
+				ctx, rangeID, et.Txn, et.Poison, async, onComplete,
 			); err != nil {
 				if ir.every.ShouldLog() {
 					log.Warningf(ctx, "failed to cleanup transaction intents: %v", err)
@@ -640,7 +891,7 @@ func (ir *IntentResolver) CleanupTxnIntentsOnGCAsync(
 			// the meantime.
 			WaitForSem: false,
 		},
-		func(ctx context.Context) {
+		ir.trackAsyncTask(func(ctx context.Context) {
 			var pushed, succeeded bool
 			defer func() {
 				if onComplete != nil {
@@ -690,13 +941,15 @@ func (ir *IntentResolver) CleanupTxnIntentsOnGCAsync(
 			// Set onComplete to nil to disable the deferred call as the call has now
 			// been delegated to the callback passed to cleanupFinishedTxnIntents.
 			onComplete = nil
-			err := ir.cleanupFinishedTxnIntents(ctx, rangeID, txn, false /* poison */, onCleanupComplete)
+			err := ir.cleanupFinishedTxnIntents(
+				ctx, rangeID, txn, false /* poison */, true /* async */, onCleanupComplete,
+			)
 			if err != nil {
 				if ir.every.ShouldLog() {
 					log.Warningf(ctx, "failed to cleanup transaction intents: %+v", err)
 				}
 			}
-		},
+		}),
 	)
 }
 
@@ -745,18 +998,21 @@ func (ir *IntentResolver) gcTxnRecord(
 		return errors.Wrapf(err, "could not GC completed transaction anchored at %s",
 			roachpb.Key(txn.Key))
 	}
+	ir.Metrics.FinalizedTxnRecordsGCed.Inc(1)
 	return nil
 }
 
 // cleanupFinishedTxnIntents cleans up a txn's extant intents and, when all
 // intents have been successfully resolved, the transaction record is GC'ed
 // asynchronously. onComplete will be called when all processing has completed
-// which is likely to be after this call returns in the case of success.
+// which is likely to be after this call returns in the case of success. async
+// indicates whether the caller is an asynchronous task.
 func (ir *IntentResolver) cleanupFinishedTxnIntents(
 	ctx context.Context,
 	rangeID roachpb.RangeID,
 	txn *roachpb.Transaction,
 	poison bool,
+	async bool,
 	onComplete func(error),
 ) (err error) {
 	defer func() {
@@ -768,8 +1024,17 @@ func (ir *IntentResolver) cleanupFinishedTxnIntents(
 	}()
 	// Resolve intents.
 	opts := ResolveOptions{Poison: poison, MinTimestamp: txn.MinTimestamp}
-	if pErr := ir.ResolveIntents(ctx, txn.LocksAsLockUpdates(), opts); pErr != nil {
-		return errors.Wrapf(pErr.GoError(), "failed to resolve intents")
+	lockUpdates := txn.LocksAsLockUpdates()
+	if err := retryAsyncCleanup(ctx, async, func(ctx context.Context) error {
+		return ir.ResolveIntents(ctx, lockUpdates, opts).GoError()
+	}); err != nil {
+		if async {
+			ir.Metrics.IntentResolverAsyncGaveUp.Inc(1)
+		}
+		return errors.Wrapf(err, "failed to resolve intents")
+	}
+	if async {
+		ir.Metrics.IntentsResolvedAsync.Inc(int64(len(lockUpdates)))
 	}
 	// Run transaction record GC outside of ir.sem. We need a new context, in case
 	// we're still connected to the client's context (which can happen when
@@ -848,10 +1113,14 @@ func (ir *IntentResolver) ResolveIntents(
 	if len(intents) == 0 {
 		return nil
 	}
+	start := timeutil.Now()
 	defer func() {
 		if pErr != nil {
 			ir.Metrics.IntentResolutionFailed.Inc(int64(len(intents)))
+		} else {
+			ir.Metrics.IntentsResolved.Inc(int64(len(intents)))
 		}
+		ir.Metrics.IntentResolutionLatency.RecordValue(timeutil.Since(start).Nanoseconds())
 	}()
 	// Avoid doing any work on behalf of expired contexts. See
 	// https://github.com/cockroachdb/cockroach/issues/15997.
@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/batcheval/result"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverbase"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
 	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
@@ -266,7 +267,7 @@ func TestCleanupIntentsAsyncThrottled(t *testing.T) {
 	var wg sync.WaitGroup
 	wg.Add(defaultTaskLimit)
 	for i := 0; i < defaultTaskLimit; i++ {
-		if err := ir.runAsyncTask(context.Background(), false, func(context.Context) {
+		if err := ir.runAsyncTask(context.Background(), false, func(context.Context, bool) {
 			wg.Done()
 			<-blocker
 		}); err != nil {
@@ -290,12 +291,15 @@ func TestCleanupIntentsAsyncThrottled(t *testing.T) {
 }
 
 // TestCleanupIntentsAsync verifies that CleanupIntentsAsync sends the expected
-// requests.
+// requests, retrying attempts which failed due to transient errors before
+// giving up.
 func TestCleanupIntentsAsync(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 	type testCase struct {
 		intents   []roachpb.Intent
 		sendFuncs []sendFunc
+		forceSync bool
+		gaveUp    bool
 	}
 	clock := hlc.NewClock(hlc.UnixNano, time.Nanosecond)
 	txn := newTransaction("txn", roachpb.Key("a"), 1, clock)
@@ -313,15 +317,56 @@ func TestCleanupIntentsAsync(t *testing.T) {
 		{
 			intents: testIntents,
 			sendFuncs: []sendFunc{
+				unavailableSendFunc,
 				singlePushTxnSendFunc(t),
-				failSendFunc,
+				resolveIntentsSendFunc(t),
+			},
+		},
+		{
+			intents: testIntents,
+			sendFuncs: []sendFunc{
+				singlePushTxnSendFunc(t),
+				unavailableSendFunc,
+				singlePushTxnSendFunc(t),
+				unavailableSendFunc,
+				singlePushTxnSendFunc(t),
+				unavailableSendFunc,
 			},
+			gaveUp: true,
+		},
+		{
+			intents:   testIntents,
+			sendFuncs: repeat(unavailableSendFunc, asyncIntentResolutionRetryOptions.MaxRetries+1),
+			gaveUp:    true,
 		},
+		// Errors which are not transient are not retried, whether the push or
+		// the resolution of the intents fails. A retry would find no sendFunc
+		// left.
 		{
 			intents: testIntents,
 			sendFuncs: []sendFunc{
+				singlePushTxnSendFunc(t),
 				failSendFunc,
 			},
+			gaveUp: true,
+		},
+		{
+			intents:   testIntents,
+			sendFuncs: []sendFunc{failSendFunc},
+			gaveUp:    true,
+		},
+		// Failing to push a live transaction is neither retried nor counted as
+		// giving up.
+		{
+			intents:   testIntents,
+			sendFuncs: []sendFunc{pushErrorSendFunc(txn)},
+		},
+		// Attempts which are run synchronously are neither retried nor
+		// counted as giving up.
+		{
+			intents:   testIntents,
+			sendFuncs: []sendFunc{unavailableSendFunc},
+			forceSync: true,
 		},
 	}
 	for _, c := range cases {
@@ -331,12 +376,25 @@ func TestCleanupIntentsAsync(t *testing.T) {
 			cfg := Config{
 				Stopper: stopper,
 				Clock:   clock,
+				TestingKnobs: kvserverbase.IntentResolverTestingKnobs{
+					ForceSyncIntentResolution: c.forceSync,
+				},
 			}
 			ir := newIntentResolverWithSendFuncs(cfg, sf, stopper)
 			err := ir.CleanupIntentsAsync(context.Background(), c.intents, true)
 			sf.drain(t)
 			stopper.Stop(context.Background())
 			assert.Nil(t, err, "error from CleanupIntentsAsync")
+			var expGaveUp int64
+			if c.gaveUp {
+				expGaveUp = 1
+			}
+			assert.Equal(t, expGaveUp, ir.Metrics.IntentResolverAsyncGaveUp.Count())
+			var expThrottled int64
+			if c.forceSync {
+				expThrottled = 1
+			}
+			assert.Equal(t, expThrottled, ir.Metrics.IntentResolverAsyncThrottled.Count())
 		})
 	}
 }
@@ -587,6 +645,85 @@ func TestCleanupTxnIntentsAsync(t *testing.T) {
 	}
 }
 
+// TestCleanupTxnIntentsAsyncRetry verifies that CleanupTxnIntentsAsync retries
+// resolving the intents of a finalized transaction when it fails due to a
+// transient error, and only when it runs asynchronously.
+func TestCleanupTxnIntentsAsyncRetry(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	type testCase struct {
+		sendFuncs []sendFunc
+		forceSync bool
+		gaveUp    bool
+	}
+	cases := []testCase{
+		{
+			sendFuncs: []sendFunc{
+				unavailableSendFunc,
+				resolveIntentsSendFunc(t),
+				gcSendFunc(t),
+			},
+		},
+		{
+			sendFuncs: repeat(unavailableSendFunc, asyncIntentResolutionRetryOptions.MaxRetries+1),
+			gaveUp:    true,
+		},
+		// Errors which are not transient are not retried.
+		{
+			sendFuncs: []sendFunc{failSendFunc},
+			gaveUp:    true,
+		},
+		// Attempts which are run synchronously are neither retried nor
+		// counted as giving up.
+		{
+			sendFuncs: []sendFunc{unavailableSendFunc},
+			forceSync: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run("", func(t *testing.T) {
+			ctx := context.Background()
+			stopper := stop.NewStopper()
+			clock := hlc.NewClock(hlc.UnixNano, time.Nanosecond)
+			txn := newTransaction("txn", roachpb.Key("a"), 1, clock)
+			txn.Status = roachpb.COMMITTED
+			txn.LockSpans = []roachpb.Span{{Key: roachpb.Key("a")}}
+			sf := newSendFuncs(t, c.sendFuncs...)
+			cfg := Config{
+				Stopper: stopper,
+				Clock:   clock,
+				TestingKnobs: kvserverbase.IntentResolverTestingKnobs{
+					ForceSyncIntentResolution: c.forceSync,
+				},
+			}
+			ir := newIntentResolverWithSendFuncs(cfg, sf, stopper)
+			endTxns := []result.EndTxnIntents{{Txn: txn}}
+			err := ir.CleanupTxnIntentsAsync(ctx, 1, endTxns, true /* allowSyncProcessing */)
+			assert.Nil(t, err)
+			sf.drain(t)
+			// The cleanup succeeds only if it is retried.
+			expFailed, expGCed := int64(1), int64(0)
+			if !c.forceSync && !c.gaveUp {
+				expFailed, expGCed = 0, 1
+			}
+			testutils.SucceedsSoon(t, func() error {
+				if f := ir.Metrics.FinalizedTxnCleanupFailed.Count(); f != expFailed {
+					return errors.Errorf("expected %d failed cleanups, got %d", expFailed, f)
+				}
+				if g := ir.Metrics.FinalizedTxnRecordsGCed.Count(); g != expGCed {
+					return errors.Errorf("expected %d GC'ed txn records, got %d", expGCed, g)
+				}
+				return nil
+			})
+			stopper.Stop(ctx)
+			var expGaveUp int64
+			if c.gaveUp {
+				expGaveUp = 1
+			}
+			assert.Equal(t, expGaveUp, ir.Metrics.IntentResolverAsyncGaveUp.Count())
+		})
+	}
+}
+
 // TestCleanupMultipleTxnIntentsAsync verifies that CleanupTxnIntentsAsync sends
 // the expected requests when multiple EndTxnIntents are provided to it.
 func TestCleanupMultipleTxnIntentsAsync(t *testing.T) {
@@ -693,11 +830,12 @@ func TestCleanupIntents(t *testing.T) {
 		roachpb.MakeIntent(&txn.TxnMeta, roachpb.Key("a")),
 	}
 	type testCase struct {
-		intents     []roachpb.Intent
-		sendFuncs   *sendFuncs
-		expectedErr bool
-		expectedNum int
-		cfg         Config
+		intents        []roachpb.Intent
+		sendFuncs      *sendFuncs
+		expectedErr    bool
+		expectedNum    int
+		expectedFailed int
+		cfg            Config
 	}
 	cases := []testCase{
 		{
@@ -713,7 +851,8 @@ func TestCleanupIntents(t *testing.T) {
 			sendFuncs: newSendFuncs(t,
 				failSendFunc,
 			),
-			expectedErr: true,
+			expectedErr:    true,
+			expectedFailed: 1,
 		},
 		{
 			intents: append(makeTxnIntents(t, clock, 3*intentResolverBatchSize),
@@ -748,13 +887,296 @@ func TestCleanupIntents(t *testing.T) {
 			c.cfg.Stopper = stopper
 			c.cfg.Clock = clock
 			ir := newIntentResolverWithSendFuncs(c.cfg, c.sendFuncs, stopper)
-			num, err := ir.CleanupIntents(context.Background(), c.intents, clock.Now(), roachpb.PUSH_ABORT)
+			num, failed, err := ir.CleanupIntents(context.Background(), c.intents, clock.Now(), roachpb.PUSH_ABORT)
 			assert.Equal(t, num, c.expectedNum, "number of resolved intents")
+			assert.Equal(t, failed, c.expectedFailed, "number of failed intents")
 			assert.Equal(t, err != nil, c.expectedErr, "error during CleanupIntents: %v", err)
 		})
 	}
 }
 
+// TestCleanupIntentsPartialPushFailure verifies that CleanupIntents resolves
+// the intents of the transactions it was able to push even if the push of
+// another transaction in the same batch fails.
+func TestCleanupIntentsPartialPushFailure(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	clock := hlc.NewClock(hlc.UnixNano, time.Nanosecond)
+	txn1 := newTransaction("txn1", roachpb.Key("a"), 1, clock)
+	txn2 := newTransaction("txn2", roachpb.Key("b"), 1, clock)
+	testIntents := []roachpb.Intent{
+		roachpb.MakeIntent(&txn1.TxnMeta, roachpb.Key("a")),
+		roachpb.MakeIntent(&txn2.TxnMeta, roachpb.Key("b")),
+	}
+	// Fail every push batch which includes txn2, attributing the error to
+	// txn2's PushTxn request.
+	pushFunc := func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		for i, ru := range ba.Requests {
+			if ru.GetPushTxn().PusheeTxn.ID == txn2.ID {
+				pErr := roachpb.NewErrorf("boom")
+				pErr.SetErrorIndex(int32(i))
+				return nil, pErr
+			}
+		}
+		return respForPushTxnBatch(t, ba), nil
+	}
+	var resolved []string
+	resolveFunc := func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		for _, ru := range ba.Requests {
+			resolved = append(resolved, string(ru.GetResolveIntent().Key))
+		}
+		return resolveIntentsSendFunc(t)(ba)
+	}
+	// The batched push fails, after which txn1 is pushed again on its own.
+	sf := newSendFuncs(t, pushFunc, pushFunc, resolveFunc)
+	stopper := stop.NewStopper()
+	defer stopper.Stop(ctx)
+	cfg := Config{
+		Stopper: stopper,
+		Clock:   clock,
+	}
+	ir := newIntentResolverWithSendFuncs(cfg, sf, stopper)
+	num, failed, err := ir.CleanupIntents(ctx, testIntents, clock.Now(), roachpb.PUSH_ABORT)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, num)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, []string{"a"}, resolved)
+	assert.Equal(t, 0, sf.len())
+	// Each pushee is accounted for once, even though txn1 was part of two
+	// push batches.
+	assert.Equal(t, int64(2), ir.Metrics.PushesAttempted.Count())
+	assert.Equal(t, int64(1), ir.Metrics.PushesSucceeded.Count())
+	assert.Equal(t, int64(1), ir.Metrics.PushesFailed.Count())
+}
+
+// TestCleanupIntentsLivePushees verifies that when several transactions in a
+// push batch cannot be pushed, as is common with PUSH_TOUCH, CleanupIntents
+// pushes each transaction at most twice, and that intents skipped because of
+// an in-flight push are not counted as failed.
+func TestCleanupIntentsLivePushees(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	clock := hlc.NewClock(hlc.UnixNano, time.Nanosecond)
+	const numLive = 4
+	pushable := newTransaction("pushable", roachpb.Key("a"), 1, clock)
+	inFlight := newTransaction("in-flight", roachpb.Key("b"), 1, clock)
+	testIntents := []roachpb.Intent{
+		roachpb.MakeIntent(&pushable.TxnMeta, roachpb.Key("a")),
+		roachpb.MakeIntent(&inFlight.TxnMeta, roachpb.Key("b")),
+	}
+	live := make(map[uuid.UUID]*roachpb.Transaction, numLive)
+	for i := 0; i < numLive; i++ {
+		txn := newTransaction("live", roachpb.Key("c"), 1, clock)
+		live[txn.ID] = txn
+		testIntents = append(testIntents, roachpb.MakeIntent(&txn.TxnMeta, roachpb.Key("c")))
+	}
+	// Fail every push batch which includes a live transaction, as PUSH_TOUCH
+	// does, and count the PushTxn requests.
+	var numPushes int64
+	pushFunc := func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		atomic.AddInt64(&numPushes, int64(len(ba.Requests)))
+		for i, ru := range ba.Requests {
+			if txn, ok := live[ru.GetPushTxn().PusheeTxn.ID]; ok {
+				pErr := roachpb.NewError(roachpb.NewTransactionPushError(*txn))
+				pErr.SetErrorIndex(int32(i))
+				return nil, pErr
+			}
+		}
+		return respForPushTxnBatch(t, ba), nil
+	}
+	// The batched push fails, after which each pushee other than the one the
+	// error is attributed to is pushed on its own.
+	sf := newSendFuncs(t, append(repeat(pushFunc, numLive+1), resolveIntentsSendFunc(t))...)
+	stopper := stop.NewStopper()
+	defer stopper.Stop(ctx)
+	cfg := Config{
+		Stopper: stopper,
+		Clock:   clock,
+	}
+	ir := newIntentResolverWithSendFuncs(cfg, sf, stopper)
+	ir.mu.inFlightPushes[inFlight.ID] = 1
+	num, failed, err := ir.CleanupIntents(ctx, testIntents, clock.Now(), roachpb.PUSH_TOUCH)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, num)
+	assert.Equal(t, numLive, failed)
+	assert.Equal(t, 0, sf.len())
+	assert.Equal(t, int64(2*numLive+1), atomic.LoadInt64(&numPushes))
+	assert.Equal(t, int64(numLive+1), ir.Metrics.PushesAttempted.Count())
+	assert.Equal(t, int64(1), ir.Metrics.PushesSucceeded.Count())
+	assert.Equal(t, int64(numLive), ir.Metrics.PushesFailed.Count())
+}
+
+// TestAsyncResolutionTimeout verifies that the timeout for asynchronously
+// processing a group of intents is derived from the cluster setting and
+// extended, up to a limit, with the number of intents in the group.
+func TestAsyncResolutionTimeout(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	st := cluster.MakeTestingClusterSettings()
+	stopper := stop.NewStopper()
+	defer stopper.Stop(ctx)
+	cfg := Config{
+		Stopper:  stopper,
+		Clock:    hlc.NewClock(hlc.UnixNano, time.Nanosecond),
+		Settings: st,
+	}
+	ir := newIntentResolverWithSendFuncs(cfg, newSendFuncs(t), stopper)
+
+	assert.Equal(t, 30*time.Second, ir.asyncResolutionTimeout(0))
+	asyncIntentResolutionTimeout.Override(ctx, &st.SV, time.Minute)
+	assert.Equal(t, time.Minute, ir.asyncResolutionTimeout(0))
+	assert.Equal(t, time.Minute+time.Second, ir.asyncResolutionTimeout(100))
+	assert.Equal(t, time.Minute+maxAsyncIntentResolutionTimeoutExtension,
+		ir.asyncResolutionTimeout(1e9))
+}
+
+// TestResolveIntentsBatchByteLimit verifies that intent resolution batches are
+// sent once the size of their requests reaches the byte limit, even if they
+// hold fewer requests than the message limit.
+func TestResolveIntentsBatchByteLimit(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	clock := hlc.NewClock(hlc.UnixNano, time.Nanosecond)
+	txn := newTransaction("txn", roachpb.Key("a"), 1, clock)
+	txn.Status = roachpb.ABORTED
+	var lockUpdates []roachpb.LockUpdate
+	for _, k := range []string{"a", "b", "c", "d"} {
+		lockUpdates = append(lockUpdates, roachpb.MakeLockUpdate(txn, roachpb.Span{Key: roachpb.Key(k)}))
+	}
+	// All requests have the same size, so a limit of twice that size sends
+	// the requests in batches of two.
+	reqSize := (&roachpb.ResolveIntentRequest{
+		RequestHeader: roachpb.RequestHeaderFromSpan(lockUpdates[0].Span),
+		IntentTxn:     lockUpdates[0].Txn,
+		Status:        lockUpdates[0].Status,
+		Poison:        true,
+	}).Size()
+	var batchLens []int
+	resolveFunc := func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		batchLens = append(batchLens, len(ba.Requests))
+		return resolveIntentsSendFuncEx(t, checkTxnAborted)(ba)
+	}
+	sf := newSendFuncs(t, resolveFunc, resolveFunc)
+	stopper := stop.NewStopper()
+	defer stopper.Stop(ctx)
+	cfg := Config{
+		Stopper: stopper,
+		Clock:   clock,
+		// Don't let the batches be sent before they reach the byte limit.
+		MaxIntentResolutionBatchWait: 10 * time.Second,
+		MaxIntentResolutionBatchIdle: 10 * time.Second,
+		TestingKnobs: kvserverbase.IntentResolverTestingKnobs{
+			MaxIntentResolutionBatchBytes: 2 * reqSize,
+		},
+	}
+	ir := newIntentResolverWithSendFuncs(cfg, sf, stopper)
+	pErr := ir.ResolveIntents(ctx, lockUpdates, ResolveOptions{Poison: true})
+	assert.Nil(t, pErr.GoError())
+	assert.Equal(t, 0, sf.len())
+	assert.Equal(t, []int{2, 2}, batchLens)
+}
+
+// TestIntentResolverMetrics verifies that pushes and intent resolutions are
+// reflected in the IntentResolver's metrics.
+func TestIntentResolverMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+	clock := hlc.NewClock(hlc.UnixNano, time.Nanosecond)
+	txn := newTransaction("txn", roachpb.Key("a"), 1, clock)
+	testIntents := []roachpb.Intent{
+		roachpb.MakeIntent(&txn.TxnMeta, roachpb.Key("a")),
+	}
+	stopper := stop.NewStopper()
+	defer stopper.Stop(ctx)
+	sf := newSendFuncs(t,
+		singlePushTxnSendFunc(t),
+		resolveIntentsSendFunc(t),
+		failSendFunc,
+	)
+	cfg := Config{
+		Stopper: stopper,
+		Clock:   clock,
+	}
+	ir := newIntentResolverWithSendFuncs(cfg, sf, stopper)
+
+	num, _, err := ir.CleanupIntents(ctx, testIntents, clock.Now(), roachpb.PUSH_ABORT)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, num)
+	assert.Equal(t, int64(1), ir.Metrics.PushesAttempted.Count())
+	assert.Equal(t, int64(1), ir.Metrics.PushesSucceeded.Count())
+	assert.Equal(t, int64(0), ir.Metrics.PushesFailed.Count())
+	assert.Equal(t, int64(1), ir.Metrics.IntentsResolved.Count())
+	assert.Equal(t, int64(1), ir.Metrics.IntentResolutionLatency.TotalCount())
+
+	_, _, err = ir.CleanupIntents(ctx, testIntents, clock.Now(), roachpb.PUSH_ABORT)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(2), ir.Metrics.PushesAttempted.Count())
+	assert.Equal(t, int64(1), ir.Metrics.PushesSucceeded.Count())
+	assert.Equal(t, int64(1), ir.Metrics.PushesFailed.Count())
+	assert.Equal(t, int64(1), ir.Metrics.IntentsResolved.Count())
+	assert.Equal(t, int64(1), ir.Metrics.IntentResolutionLatency.TotalCount())
+	assert.Equal(t, 0, sf.len())
+
+	// An asynchronous cleanup is accounted for in the running gauge until the
+	// task completes, after which its intents are counted as resolved
+	// asynchronously and the transaction record is GC'ed.
+	endTxn := newTransaction("endtxn", roachpb.Key("b"), 1, clock)
+	endTxn.Status = roachpb.COMMITTED
+	endTxn.LockSpans = []roachpb.Span{{Key: roachpb.Key("b")}}
+	unblockResolve := make(chan struct{})
+	sf.mu.Lock()
+	sf.pushFrontLocked(
+		func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+			<-unblockResolve
+			return resolveIntentsSendFunc(t)(ba)
+		},
+		gcSendFunc(t),
+	)
+	sf.mu.Unlock()
+	endTxns := []result.EndTxnIntents{{Txn: endTxn}}
+	assert.Nil(t, ir.CleanupTxnIntentsAsync(ctx, 1, endTxns, false /* allowSyncProcessing */))
+	testutils.SucceedsSoon(t, func() error {
+		if v := ir.Metrics.IntentResolverAsyncRunning.Value(); v != 1 {
+			return errors.Errorf("expected 1 running task, got %d", v)
+		}
+		return nil
+	})
+	close(unblockResolve)
+	sf.drain(t)
+	testutils.SucceedsSoon(t, func() error {
+		if v := ir.Metrics.IntentResolverAsyncRunning.Value(); v != 0 {
+			return errors.Errorf("expected no running tasks, got %d", v)
+		}
+		if c := ir.Metrics.FinalizedTxnRecordsGCed.Count(); c != 1 {
+			return errors.Errorf("expected 1 GC'ed txn record, got %d", c)
+		}
+		return nil
+	})
+	assert.Equal(t, int64(2), ir.Metrics.IntentsResolved.Count())
+	assert.Equal(t, int64(1), ir.Metrics.IntentsResolvedAsync.Count())
+	assert.Equal(t, int64(2), ir.Metrics.IntentResolutionLatency.TotalCount())
+
+	// When the cleanup is run synchronously because no async task is
+	// available, its intents are not counted as resolved asynchronously.
+	syncSF := newSendFuncs(t,
+		singlePushTxnSendFunc(t),
+		resolveIntentsSendFunc(t),
+	)
+	syncCfg := Config{
+		Stopper: stopper,
+		Clock:   clock,
+		TestingKnobs: kvserverbase.IntentResolverTestingKnobs{
+			ForceSyncIntentResolution: true,
+		},
+	}
+	syncIR := newIntentResolverWithSendFuncs(syncCfg, syncSF, stopper)
+	assert.Nil(t, syncIR.CleanupIntentsAsync(ctx, testIntents, true /* allowSyncProcessing */))
+	assert.Equal(t, 0, syncSF.len())
+	assert.Equal(t, int64(1), syncIR.Metrics.IntentsResolved.Count())
+	assert.Equal(t, int64(0), syncIR.Metrics.IntentsResolvedAsync.Count())
+	assert.Equal(t, int64(0), syncIR.Metrics.IntentResolverAsyncRunning.Value())
+}
+
 func newTransaction(
 	name string, baseKey roachpb.Key, userPriority roachpb.UserPriority, clock *hlc.Clock,
 ) *roachpb.Transaction {
@@ -797,6 +1219,9 @@ func newIntentResolverWithSendFuncs(
 		})
 	db := kv.NewDB(log.MakeTestingAmbientCtxWithNewTracer(), txnSenderFactory, c.Clock, stopper)
 	c.DB = db
+	if c.Settings == nil {
+		c.Settings = cluster.MakeTestingClusterSettings()
+	}
 	c.MaxGCBatchWait = time.Nanosecond
 	return New(c)
 }
@@ -896,6 +1321,21 @@ func failSendFunc(roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error)
 	return nil, roachpb.NewError(fmt.Errorf("boom"))
 }
 
+// unavailableSendFunc fails a request with a transient error.
+func unavailableSendFunc(roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+	return nil, roachpb.NewError(&roachpb.NodeUnavailableError{})
+}
+
+// pushErrorSendFunc fails a push of the live transaction txn, as PUSH_TOUCH
+// does.
+func pushErrorSendFunc(txn *roachpb.Transaction) sendFunc {
+	return func(roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		pErr := roachpb.NewError(roachpb.NewTransactionPushError(*txn))
+		pErr.SetErrorIndex(0)
+		return nil, pErr
+	}
+}
+
 func gcSendFunc(t *testing.T) sendFunc {
 	return func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
 		resp := &roachpb.BatchResponse{}
@@ -10,7 +10,11 @@
 
 package intentresolver
 
-import "github.com/cockroachdb/cockroach/pkg/util/metric"
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+)
 
 var (
 	// Intent resolver metrics.
@@ -20,6 +24,20 @@ var (
 		Measurement: "Intent Resolutions",
 		Unit:        metric.Unit_COUNT,
 	}
+	metaIntentResolverAsyncGaveUp = metric.Metadata{
+		Name: "intentresolver.async.gave_up",
+		Help: "Number of asynchronous attempts to clean up a group of intents " +
+			"which failed and were abandoned, after exhausting their retries if the " +
+			"failure was transient",
+		Measurement: "Intent Resolutions",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaIntentResolverAsyncRunning = metric.Metadata{
+		Name:        "intentresolver.async.running",
+		Help:        "Number of asynchronous intent cleanup tasks currently running",
+		Measurement: "Tasks",
+		Unit:        metric.Unit_COUNT,
+	}
 	metaFinalizedTxnCleanupFailed = metric.Metadata{
 		Name: "intentresolver.finalized_txns.failed",
 		Help: "Number of finalized transaction cleanup failures. Transaction " +
@@ -36,26 +54,103 @@ var (
 		Measurement: "Intent Resolutions",
 		Unit:        metric.Unit_COUNT,
 	}
+	metaFinalizedTxnRecordsGCed = metric.Metadata{
+		Name:        "intentresolver.finalized_txns.gced",
+		Help:        "Number of finalized transaction records garbage collected after their intents were resolved",
+		Measurement: "Txn Records",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaIntentsResolved = metric.Metadata{
+		Name:        "intentresolver.intents.resolved",
+		Help:        "Number of intents successfully resolved, both synchronously and asynchronously",
+		Measurement: "Intent Resolutions",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaIntentsResolvedAsync = metric.Metadata{
+		Name:        "intentresolver.intents.resolved_async",
+		Help:        "Number of intents successfully resolved by asynchronous cleanup tasks",
+		Measurement: "Intent Resolutions",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaIntentResolutionLatency = metric.Metadata{
+		Name:        "intentresolver.intents.latency",
+		Help:        "Latency of resolving a batch of intents",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	metaPushesAttempted = metric.Metadata{
+		Name:        "intentresolver.pushes.attempted",
+		Help:        "Number of transactions the intent resolver attempted to push",
+		Measurement: "Pushes",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaPushesSucceeded = metric.Metadata{
+		Name:        "intentresolver.pushes.succeeded",
+		Help:        "Number of transactions successfully pushed by the intent resolver",
+		Measurement: "Pushes",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaPushesFailed = metric.Metadata{
+		Name: "intentresolver.pushes.failed",
+		Help: "Number of transactions the intent resolver failed to push. The unit " +
+			"of measurement is a single pushee, so if a batch of PushTxn requests " +
+			"fails as a whole, the metric will be incremented for each request in the batch.",
+		Measurement: "Pushes",
+		Unit:        metric.Unit_COUNT,
+	}
 )
 
 // Metrics contains the metrics for the IntentResolver.
 type Metrics struct {
 	IntentResolverAsyncThrottled *metric.Counter
 
+	// Counter tracking groups of intents whose asynchronous cleanup failed and
+	// was abandoned.
+	IntentResolverAsyncGaveUp *metric.Counter
+
+	// Gauge tracking the number of asynchronous cleanup tasks currently
+	// running. Since asynchronous tasks never wait for the task semaphore,
+	// this is the backlog of asynchronous cleanup work.
+	IntentResolverAsyncRunning *metric.Gauge
+
 	// Counter tracking intent + transaction record cleanup failures.
 	FinalizedTxnCleanupFailed *metric.Counter
 
 	// Counter tracking intent cleanup failures.
 	IntentResolutionFailed *metric.Counter
+
+	// Counter tracking txn records GC'ed after their intents were resolved.
+	FinalizedTxnRecordsGCed *metric.Counter
+
+	// Counters tracking intents resolved, in total and by asynchronous tasks.
+	IntentsResolved      *metric.Counter
+	IntentsResolvedAsync *metric.Counter
+
+	// Histogram tracking the latency of each call to ResolveIntents.
+	IntentResolutionLatency *metric.Histogram
+
+	// Counters tracking the outcome of transaction pushes.
+	PushesAttempted *metric.Counter
+	PushesSucceeded *metric.Counter
+	PushesFailed    *metric.Counter
 }
 
 // MetricStruct implements the metric.Struct interface.
 func (*Metrics) MetricStruct() {}
 
-func makeMetrics() Metrics {
+func makeMetrics(histogramWindow time.Duration) Metrics {
 	return Metrics{
 		IntentResolverAsyncThrottled: metric.NewCounter(metaIntentResolverAsyncThrottled),
+		IntentResolverAsyncGaveUp:    metric.NewCounter(metaIntentResolverAsyncGaveUp),
+		IntentResolverAsyncRunning:   metric.NewGauge(metaIntentResolverAsyncRunning),
 		FinalizedTxnCleanupFailed:    metric.NewCounter(metaFinalizedTxnCleanupFailed),
 		IntentResolutionFailed:       metric.NewCounter(metaIntentCleanupFailed),
+		FinalizedTxnRecordsGCed:      metric.NewCounter(metaFinalizedTxnRecordsGCed),
+		IntentsResolved:              metric.NewCounter(metaIntentsResolved),
+		IntentsResolvedAsync:         metric.NewCounter(metaIntentsResolvedAsync),
+		IntentResolutionLatency:      metric.NewLatency(metaIntentResolutionLatency, histogramWindow),
+		PushesAttempted:              metric.NewCounter(metaPushesAttempted),
+		PushesSucceeded:              metric.NewCounter(metaPushesSucceeded),
+		PushesFailed:                 metric.NewCounter(metaPushesFailed),
 	}
 }
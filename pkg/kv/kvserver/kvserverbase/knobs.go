@@ -60,4 +60,8 @@ type IntentResolverTestingKnobs struct {
 	// MaxIntentResolutionBatchSize overrides the maximum number of intent
 	// resolution requests which can be sent in a single batch.
 	MaxIntentResolutionBatchSize int
+
+	// MaxIntentResolutionBatchBytes overrides the maximum size in bytes of the
+	// intent resolution requests which can be sent in a single batch.
+	MaxIntentResolutionBatchBytes int
 }
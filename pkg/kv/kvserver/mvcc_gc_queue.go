@@ -22,6 +22,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/intentresolver"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverbase"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/spanconfig"
 	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
@@ -108,6 +109,14 @@ type mvccGCQueue struct {
 	*baseQueue
 }
 
+// mvccGCQueueEnabled controls whether the MVCC GC queue is enabled.
+var mvccGCQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.mvcc_gc_queue.enabled",
+	"whether the MVCC GC queue is enabled",
+	true,
+)
+
 // newMVCCGCQueue returns a new instance of mvccGCQueue.
 func newMVCCGCQueue(store *Store) *mvccGCQueue {
 	mgcq := &mvccGCQueue{}
@@ -125,6 +134,7 @@ func newMVCCGCQueue(store *Store) *mvccGCQueue {
 				}
 				return timeout
 			},
+			enabledSetting:  mvccGCQueueEnabled,
 			successes:       store.metrics.MVCCGCQueueSuccesses,
 			failures:        store.metrics.MVCCGCQueueFailures,
 			pending:         store.metrics.MVCCGCQueuePending,
@@ -572,13 +582,10 @@ func (mgcq *mvccGCQueue) process(
 			storeID:             mgcq.store.StoreID(),
 		},
 		func(ctx context.Context, intents []roachpb.Intent) error {
-			intentCount, err := repl.store.intentResolver.
+			resolved, failed, err := repl.store.intentResolver.
 				CleanupIntents(ctx, intents, gcTimestamp, roachpb.PUSH_TOUCH)
-			if err == nil {
-				mgcq.store.metrics.GCResolveSuccess.Inc(int64(intentCount))
-			} else {
-				mgcq.store.metrics.GCResolveFailed.Inc(int64(intentCount))
-			}
+			mgcq.store.metrics.GCResolveSuccess.Inc(int64(resolved))
+			mgcq.store.metrics.GCResolveFailed.Inc(int64(failed))
 			return err
 		},
 		func(ctx context.Context, txn *roachpb.Transaction) error {
@@ -312,6 +312,10 @@ type queueConfig struct {
 	processDestroyedReplicas bool
 	// processTimeout returns the timeout for processing a replica.
 	processTimeoutFunc queueProcessTimeoutFunc
+	// enabledSetting, if set, is a cluster setting which operators can use to
+	// turn the queue off at runtime. While it is false, no replicas are added
+	// to the queue (replicas which are already queued are still processed).
+	enabledSetting *settings.BoolSetting
 	// successes is a counter of replicas processed successfully.
 	successes *metric.Counter
 	// failures is a counter of replicas which failed processing.
@@ -509,6 +513,15 @@ func (bq *baseQueue) SetDisabled(disabled bool) {
 	bq.mu.Unlock()
 }
 
+// disabledLocked returns whether the queue has been disabled, either through
+// SetDisabled or through its enabledSetting. Requires that bq.mu is held.
+func (bq *baseQueue) disabledLocked() bool {
+	if bq.mu.disabled {
+		return true
+	}
+	return bq.enabledSetting != nil && !bq.enabledSetting.Get(&bq.store.ClusterSettings().SV)
+}
+
 // lockProcessing locks all processing in the baseQueue. It returns
 // a function to unlock processing.
 func (bq *baseQueue) lockProcessing() func() {
@@ -621,7 +634,7 @@ func (bq *baseQueue) maybeAdd(ctx context.Context, repl replicaInQueue, now hlc.
 	}
 
 	bq.mu.Lock()
-	stopped := bq.mu.stopped || bq.mu.disabled
+	stopped := bq.mu.stopped || bq.disabledLocked()
 	bq.mu.Unlock()
 
 	if stopped {
@@ -690,7 +703,7 @@ func (bq *baseQueue) addInternal(
 		return false, errQueueStopped
 	}
 
-	if bq.mu.disabled {
+	if bq.disabledLocked() {
 		if log.V(3) {
 			log.Infof(ctx, "queue disabled")
 		}
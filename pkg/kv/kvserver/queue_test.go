@@ -23,6 +23,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/spanconfig"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/bootstrap"
@@ -1160,6 +1161,59 @@ func TestBaseQueueDisable(t *testing.T) {
 	}
 }
 
+// testQueueEnabled enables and disables the queue in
+// TestBaseQueueDisabledBySetting.
+var testQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"testing.queue.enabled",
+	"whether the test queue is enabled",
+	true,
+)
+
+// TestBaseQueueDisabledBySetting verifies that a queue with an enabledSetting
+// does not accept replicas while the setting is false.
+func TestBaseQueueDisabledBySetting(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+	tc := testContext{}
+	stopper := stop.NewStopper()
+	ctx := context.Background()
+	defer stopper.Stop(ctx)
+	tc.Start(ctx, t, stopper)
+
+	r, err := tc.store.GetReplica(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testQueue := &testQueueImpl{
+		shouldQueueFn: func(now hlc.ClockTimestamp, r *Replica) (bool, float64) {
+			return true, 1.0
+		},
+	}
+	bq := makeTestBaseQueue("test", testQueue, tc.store, queueConfig{
+		maxSize:        2,
+		enabledSetting: testQueueEnabled,
+	})
+
+	sv := &tc.store.ClusterSettings().SV
+	testQueueEnabled.Override(ctx, sv, false)
+	if _, err := bq.testingAdd(ctx, r, 1.0); !errors.Is(err, errQueueDisabled) {
+		t.Fatalf("expected %v, got %v", errQueueDisabled, err)
+	}
+	if l := bq.Length(); l != 0 {
+		t.Fatalf("expected empty queue, got length %d", l)
+	}
+
+	testQueueEnabled.Override(ctx, sv, true)
+	if added, err := bq.testingAdd(ctx, r, 1.0); err != nil || !added {
+		t.Fatalf("expected replica to be added, got added=%t err=%v", added, err)
+	}
+	if l := bq.Length(); l != 1 {
+		t.Fatalf("expected queue length 1, got %d", l)
+	}
+}
+
 type parallelQueueImpl struct {
 	testQueueImpl
 	processBlocker chan struct{}
@@ -152,6 +152,14 @@ type raftLogQueue struct {
 	logSnapshots util.EveryN
 }
 
+// raftLogQueueEnabled controls whether the Raft log queue is enabled.
+var raftLogQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.raft_log_queue.enabled",
+	"whether the Raft log queue is enabled; disabling it stops Raft log truncation",
+	true,
+)
+
 // newRaftLogQueue returns a new instance of raftLogQueue. Replicas are passed
 // to the queue both proactively (triggered by write load) and periodically
 // (via the scanner). When processing a replica, the queue decides whether the
@@ -172,6 +180,7 @@ func newRaftLogQueue(store *Store, db *kv.DB) *raftLogQueue {
 			needsLease:           false,
 			needsSystemConfig:    false,
 			acceptsUnsplitRanges: true,
+			enabledSetting:       raftLogQueueEnabled,
 			successes:            store.metrics.RaftLogQueueSuccesses,
 			failures:             store.metrics.RaftLogQueueFailures,
 			pending:              store.metrics.RaftLogQueuePending,
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverpb"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/spanconfig"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
@@ -38,6 +39,14 @@ type raftSnapshotQueue struct {
 	*baseQueue
 }
 
+// raftSnapshotQueueEnabled controls whether the Raft snapshot queue is enabled.
+var raftSnapshotQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.raft_snapshot_queue.enabled",
+	"whether the Raft snapshot queue is enabled; disabling it can leave followers that need a snapshot behind indefinitely",
+	true,
+)
+
 // newRaftSnapshotQueue returns a new instance of raftSnapshotQueue.
 func newRaftSnapshotQueue(store *Store) *raftSnapshotQueue {
 	rq := &raftSnapshotQueue{}
@@ -52,6 +61,7 @@ func newRaftSnapshotQueue(store *Store) *raftSnapshotQueue {
 			needsSystemConfig:    false,
 			acceptsUnsplitRanges: true,
 			processTimeoutFunc:   makeRateLimitedTimeoutFunc(recoverySnapshotRate),
+			enabledSetting:       raftSnapshotQueueEnabled,
 			successes:            store.metrics.RaftSnapshotQueueSuccesses,
 			failures:             store.metrics.RaftSnapshotQueueFailures,
 			pending:              store.metrics.RaftSnapshotQueuePending,
@@ -28,6 +28,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/storage"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
 	"github.com/cockroachdb/cockroach/pkg/util/stop"
 	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
@@ -141,6 +142,33 @@ func (s raftTransportStatsSlice) Len() int           { return len(s) }
 func (s raftTransportStatsSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s raftTransportStatsSlice) Less(i, j int) bool { return s[i].nodeID < s[j].nodeID }
 
+var metaRaftTransportSendsDropped = metric.Metadata{
+	Name:        "raft.transport.sends_dropped",
+	Help:        "Number of outgoing Raft messages dropped by the Raft Transport",
+	Measurement: "Messages",
+	Unit:        metric.Unit_COUNT,
+}
+
+// RaftTransportMetrics is the set of metrics for a RaftTransport. Unlike
+// the Raft metrics in StoreMetrics, these are shared by all of a node's
+// stores.
+type RaftTransportMetrics struct {
+	// SendsDropped counts outgoing messages that were dropped, either
+	// because the queue for the destination node was full, the circuit
+	// breaker for the node was tripped, or the queue was torn down before
+	// the message could be sent.
+	SendsDropped *metric.Counter
+}
+
+func makeRaftTransportMetrics() RaftTransportMetrics {
+	return RaftTransportMetrics{
+		SendsDropped: metric.NewCounter(metaRaftTransportSendsDropped),
+	}
+}
+
+// MetricStruct implements the metric.Struct interface.
+func (RaftTransportMetrics) MetricStruct() {}
+
 // RaftTransport handles the rpc messages for raft.
 //
 // The raft transport is asynchronous with respect to the caller, and
@@ -163,6 +191,7 @@ type RaftTransport struct {
 	stats    [rpc.NumConnectionClasses]syncutil.IntMap // map[roachpb.NodeID]*raftTransportStats
 	dialer   *nodedialer.Dialer
 	handlers syncutil.IntMap // map[roachpb.StoreID]*RaftMessageHandler
+	metrics  RaftTransportMetrics
 }
 
 // NewDummyRaftTransport returns a dummy raft transport for use in tests which
@@ -189,6 +218,7 @@ func NewRaftTransport(
 
 		stopper: stopper,
 		dialer:  dialer,
+		metrics: makeRaftTransportMetrics(),
 	}
 
 	if grpcServer != nil {
@@ -277,6 +307,11 @@ func NewRaftTransport(
 	return t
 }
 
+// Metrics returns the RaftTransport's metrics struct.
+func (t *RaftTransport) Metrics() *RaftTransportMetrics {
+	return &t.metrics
+}
+
 func (t *RaftTransport) queuedMessageCount() int64 {
 	var n int64
 	addLength := func(k int64, v unsafe.Pointer) bool {
@@ -571,6 +606,7 @@ func (t *RaftTransport) SendAsync(
 	defer func() {
 		if !sent {
 			atomic.AddInt64(&stats.clientDropped, 1)
+			t.metrics.SendsDropped.Inc(1)
 		}
 	}()
 
@@ -635,6 +671,7 @@ func (t *RaftTransport) startProcessNewQueue(
 			select {
 			case <-ch:
 				atomic.AddInt64(&stats.clientDropped, 1)
+				t.metrics.SendsDropped.Inc(1)
 			default:
 				return
 			}
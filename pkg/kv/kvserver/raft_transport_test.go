@@ -449,6 +449,7 @@ func TestRaftTransportCircuitBreaker(t *testing.T) {
 		}
 		return nil
 	})
+	require.NotZero(t, clientTransport.Metrics().SendsDropped.Count())
 
 	// Now, gossip address of server.
 	rttc.GossipNode(serverReplica.NodeID, serverAddr)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/spanconfig"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
@@ -83,6 +84,14 @@ type replicaGCQueue struct {
 	db      *kv.DB
 }
 
+// replicaGCQueueEnabled controls whether the replica GC queue is enabled.
+var replicaGCQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.replica_gc_queue.enabled",
+	"whether the replica GC queue is enabled",
+	true,
+)
+
 // newReplicaGCQueue returns a new instance of replicaGCQueue.
 func newReplicaGCQueue(store *Store, db *kv.DB) *replicaGCQueue {
 	rgcq := &replicaGCQueue{
@@ -99,6 +108,7 @@ func newReplicaGCQueue(store *Store, db *kv.DB) *replicaGCQueue {
 			needsSystemConfig:        false,
 			acceptsUnsplitRanges:     true,
 			processDestroyedReplicas: true,
+			enabledSetting:           replicaGCQueueEnabled,
 			successes:                store.metrics.ReplicaGCQueueSuccesses,
 			failures:                 store.metrics.ReplicaGCQueueFailures,
 			pending:                  store.metrics.ReplicaGCQueuePending,
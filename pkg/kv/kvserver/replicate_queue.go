@@ -168,6 +168,14 @@ type replicateQueue struct {
 	lastLeaseTransfer atomic.Value // read and written by scanner & queue goroutines
 }
 
+// replicateQueueEnabled controls whether the replicate queue is enabled.
+var replicateQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.replicate_queue.enabled",
+	"whether the replicate queue is enabled; disabling it stops up- and down-replication and rebalancing",
+	true,
+)
+
 // newReplicateQueue returns a new instance of replicateQueue.
 func newReplicateQueue(store *Store, allocator Allocator) *replicateQueue {
 	rq := &replicateQueue{
@@ -188,6 +196,7 @@ func newReplicateQueue(store *Store, allocator Allocator) *replicateQueue {
 			// timeout based on the range size and the sending rate in addition
 			// to consulting the setting which controls the minimum timeout.
 			processTimeoutFunc: makeRateLimitedTimeoutFunc(rebalanceSnapshotRate),
+			enabledSetting:     replicateQueueEnabled,
 			successes:          store.metrics.ReplicateQueueSuccesses,
 			failures:           store.metrics.ReplicateQueueFailures,
 			pending:            store.metrics.ReplicateQueuePending,
@@ -19,6 +19,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverbase"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/spanconfig"
 	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
@@ -56,6 +57,14 @@ type splitQueue struct {
 	loadBasedCount telemetry.Counter
 }
 
+// splitQueueEnabled controls whether the split queue is enabled.
+var splitQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.split_queue.enabled",
+	"whether the split queue is enabled; disabling it allows ranges to grow beyond their max size",
+	true,
+)
+
 // newSplitQueue returns a new instance of splitQueue.
 func newSplitQueue(store *Store, db *kv.DB) *splitQueue {
 	var purgChan <-chan time.Time
@@ -79,6 +88,7 @@ func newSplitQueue(store *Store, db *kv.DB) *splitQueue {
 			needsLease:           true,
 			needsSystemConfig:    true,
 			acceptsUnsplitRanges: true,
+			enabledSetting:       splitQueueEnabled,
 			successes:            store.metrics.SplitQueueSuccesses,
 			failures:             store.metrics.SplitQueueFailures,
 			pending:              store.metrics.SplitQueuePending,
@@ -1760,6 +1760,10 @@ func ReadStoreIdent(ctx context.Context, eng storage.Engine) (roachpb.StoreIdent
 func (s *Store) Start(ctx context.Context, stopper *stop.Stopper) error {
 	s.stopper = stopper
 
+	if err := s.cfg.RaftConfig.Validate(); err != nil {
+		return err
+	}
+
 	// Populate the store ident. If not bootstrapped, ReadStoreIntent will
 	// return an error.
 	ident, err := ReadStoreIdent(ctx, s.engine)
@@ -1822,13 +1826,15 @@ func (s *Store) Start(ctx context.Context, stopper *stop.Stopper) error {
 	}
 
 	s.intentResolver = intentresolver.New(intentresolver.Config{
-		Clock:                s.cfg.Clock,
-		DB:                   s.db,
-		Stopper:              stopper,
-		TaskLimit:            s.cfg.IntentResolverTaskLimit,
-		AmbientCtx:           s.cfg.AmbientCtx,
-		TestingKnobs:         s.cfg.TestingKnobs.IntentResolverKnobs,
-		RangeDescriptorCache: intentResolverRangeCache,
+		Clock:                   s.cfg.Clock,
+		Settings:                s.cfg.Settings,
+		DB:                      s.db,
+		Stopper:                 stopper,
+		TaskLimit:               s.cfg.IntentResolverTaskLimit,
+		AmbientCtx:              s.cfg.AmbientCtx,
+		TestingKnobs:            s.cfg.TestingKnobs.IntentResolverKnobs,
+		RangeDescriptorCache:    intentResolverRangeCache,
+		HistogramWindowInterval: s.cfg.HistogramWindowInterval,
 	})
 	s.metrics.registry.AddMetricStruct(s.intentResolver.Metrics)
 
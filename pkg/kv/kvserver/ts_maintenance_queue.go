@@ -16,6 +16,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/spanconfig"
 	"github.com/cockroachdb/cockroach/pkg/storage"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
@@ -84,6 +85,15 @@ type timeSeriesMaintenanceQueue struct {
 	mem            *mon.BytesMonitor
 }
 
+// timeSeriesMaintenanceQueueEnabled controls whether the time series
+// maintenance queue is enabled.
+var timeSeriesMaintenanceQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.timeseries_maintenance_queue.enabled",
+	"whether the time series maintenance queue is enabled",
+	true,
+)
+
 // newTimeSeriesMaintenanceQueue returns a new instance of
 // timeSeriesMaintenanceQueue.
 func newTimeSeriesMaintenanceQueue(
@@ -112,6 +122,7 @@ func newTimeSeriesMaintenanceQueue(
 			needsLease:           true,
 			needsSystemConfig:    false,
 			acceptsUnsplitRanges: true,
+			enabledSetting:       timeSeriesMaintenanceQueueEnabled,
 			successes:            store.metrics.TimeSeriesMaintenanceQueueSuccesses,
 			failures:             store.metrics.TimeSeriesMaintenanceQueueFailures,
 			pending:              store.metrics.TimeSeriesMaintenanceQueuePending,
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -516,6 +517,9 @@ func (cfg *Config) CreateEngines(ctx context.Context) (Engines, error) {
 		return Engines{}, errors.Errorf("engines already created")
 	}
 	cfg.enginesCreated = true
+	if err := validateWALDirs(cfg.Stores.Specs); err != nil {
+		return Engines{}, err
+	}
 	details := []redact.RedactableString{redact.Sprintf("Pebble cache size: %s", humanizeutil.IBytes(cfg.CacheSize))}
 	pebbleCache := pebble.NewCache(cfg.CacheSize)
 	defer pebbleCache.Unref()
@@ -632,6 +636,13 @@ func (cfg *Config) CreateEngines(ctx context.Context) (Engines, error) {
 					return nil, err
 				}
 			}
+			if len(spec.WALDir) > 0 {
+				if len(pebbleConfig.Opts.WALDir) > 0 && pebbleConfig.Opts.WALDir != spec.WALDir {
+					return nil, errors.Errorf("store %d: wal-dir %s conflicts with Pebble option wal_dir=%s",
+						i, spec.WALDir, pebbleConfig.Opts.WALDir)
+				}
+				pebbleConfig.Opts.WALDir = spec.WALDir
+			}
 			if len(spec.RocksDBOptions) > 0 {
 				return nil, errors.Errorf("store %d: using Pebble storage engine but StoreSpec provides RocksDB options", i)
 			}
@@ -661,6 +672,31 @@ func (cfg *Config) CreateEngines(ctx context.Context) (Engines, error) {
 	return enginesCopy, nil
 }
 
+// validateWALDirs returns an error if the WAL directory of a store is also
+// used as the WAL directory or the data directory of another store. Pebble
+// only locks the data directory, so the stores' WAL files would otherwise
+// silently collide in one directory.
+func validateWALDirs(specs []base.StoreSpec) error {
+	for i, spec := range specs {
+		if spec.WALDir == "" {
+			continue
+		}
+		walDir := filepath.Clean(spec.WALDir)
+		for j, other := range specs {
+			if i == j || other.InMemory {
+				continue
+			}
+			if other.WALDir != "" && filepath.Clean(other.WALDir) == walDir && i < j {
+				return errors.Errorf("store %d and store %d use the same wal-dir %s", i, j, spec.WALDir)
+			}
+			if filepath.Clean(other.Path) == walDir {
+				return errors.Errorf("wal-dir %s of store %d is the path of store %d", spec.WALDir, i, j)
+			}
+		}
+	}
+	return nil
+}
+
 // InitNode parses node attributes and bootstrap addresses.
 func (cfg *Config) InitNode(ctx context.Context) error {
 	cfg.readEnvironmentVariables()
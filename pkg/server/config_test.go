@@ -14,12 +14,14 @@ import (
 	"context"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/base"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/util"
 	"github.com/cockroachdb/cockroach/pkg/util/envutil"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
@@ -50,6 +52,65 @@ func TestParseInitNodeAttributes(t *testing.T) {
 	}
 }
 
+// TestCreateEnginesValidatesWALDirs verifies that CreateEngines rejects store
+// specs whose WAL directory is also used by another store.
+func TestCreateEnginesValidatesWALDirs(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+	ctx := context.Background()
+
+	testCases := []struct {
+		specs  []base.StoreSpec
+		expErr string
+	}{
+		{
+			specs: []base.StoreSpec{
+				{Path: "/mnt/a", WALDir: "/mnt/wal"},
+				{Path: "/mnt/b", WALDir: "/mnt/wal/"},
+			},
+			expErr: "store 0 and store 1 use the same wal-dir /mnt/wal",
+		},
+		{
+			specs: []base.StoreSpec{
+				{Path: "/mnt/a", WALDir: "/mnt/b"},
+				{Path: "/mnt/b"},
+			},
+			expErr: "wal-dir /mnt/b of store 0 is the path of store 1",
+		},
+		{
+			specs: []base.StoreSpec{
+				{Path: "/mnt/a"},
+				{Path: "/mnt/b", WALDir: "/mnt/a"},
+			},
+			expErr: "wal-dir /mnt/a of store 1 is the path of store 0",
+		},
+	}
+	for _, tc := range testCases {
+		cfg := MakeConfig(ctx, cluster.MakeTestingClusterSettings())
+		cfg.Stores = base.StoreSpecList{Specs: tc.specs}
+		_, err := cfg.CreateEngines(ctx)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), tc.expErr)
+	}
+
+	// Stores with distinct WAL directories are accepted.
+	dir, cleanup := testutils.TempDir(t)
+	defer cleanup()
+	cfg := MakeConfig(ctx, cluster.MakeTestingClusterSettings())
+	cfg.Stores = base.StoreSpecList{Specs: []base.StoreSpec{
+		{Path: filepath.Join(dir, "a"), WALDir: filepath.Join(dir, "wal-a")},
+		{Path: filepath.Join(dir, "b"), WALDir: filepath.Join(dir, "wal-b")},
+	}}
+	engines, err := cfg.CreateEngines(ctx)
+	require.NoError(t, err)
+	defer engines.Close()
+	for _, walDir := range []string{"wal-a", "wal-b"} {
+		logs, err := filepath.Glob(filepath.Join(dir, walDir, "*.log"))
+		require.NoError(t, err)
+		require.NotEmpty(t, logs)
+	}
+}
+
 // TestParseJoinUsingAddrs verifies that JoinList is parsed
 // correctly.
 func TestParseJoinUsingAddrs(t *testing.T) {
@@ -437,6 +437,7 @@ func NewServer(cfg Config, stopper *stop.Stopper) (*Server, error) {
 	raftTransport := kvserver.NewRaftTransport(
 		cfg.AmbientCtx, st, nodeDialer, grpcServer.Server, stopper,
 	)
+	registry.AddMetricStruct(raftTransport.Metrics())
 
 	ctSender := sidetransport.NewSender(stopper, st, clock, nodeDialer)
 	stores := kvserver.NewStores(cfg.AmbientCtx, clock)
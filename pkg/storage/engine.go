@@ -888,6 +888,8 @@ type Metrics struct {
 	// We do not split this metric across these two reasons, but they can be
 	// distinguished in the pebble logs.
 	WriteStallCount int64
+	// WriteStallDuration is the cumulative time spent in write stalls.
+	WriteStallDuration time.Duration
 	// DiskSlowCount counts the number of times Pebble records disk slowness.
 	DiskSlowCount int64
 	// DiskStallCount counts the number of times Pebble observes slow writes
@@ -68,11 +68,15 @@ var minWALSyncInterval = settings.RegisterDurationSetting(
 
 // MaxIntentsPerWriteIntentError sets maximum number of intents returned in
 // WriteIntentError in operations that return multiple intents per error.
-// Currently it is used in Scan, ReverseScan, and ExportToSST.
+// Currently it is used in Scan, ReverseScan, ExportToSST, ClearRange,
+// DeleteRange, RevertRange, and AddSSTable. Intents beyond the limit are not
+// collected; they are discovered and handled when the request is retried
+// after the collected intents have been resolved.
 var MaxIntentsPerWriteIntentError = settings.RegisterIntSetting(
 	settings.TenantWritable,
 	"storage.mvcc.max_intents_per_error",
-	"maximum number of intents returned in error during export of scan requests",
+	"maximum number of intents returned in a single WriteIntentError by scans "+
+		"and other operations which collect multiple intents",
 	maxIntentsPerWriteIntentErrorDefault)
 
 var rocksdbConcurrency = envutil.EnvOrDefaultInt(
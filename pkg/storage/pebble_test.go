@@ -478,6 +478,15 @@ func TestPebbleMetricEventListener(t *testing.T) {
 	require.Equal(t, int64(1), p.writeStallCount)
 	require.Equal(t, int64(0), p.diskSlowCount)
 	require.Equal(t, int64(0), p.diskStallCount)
+	require.NotZero(t, p.writeStallStartNanos)
+	time.Sleep(time.Millisecond)
+	p.eventListener.WriteStallEnd()
+	require.Zero(t, p.writeStallStartNanos)
+	stallDuration := p.GetMetrics().WriteStallDuration
+	require.GreaterOrEqual(t, stallDuration, time.Millisecond)
+	// A WriteStallEnd without a matching WriteStallBegin adds no time.
+	p.eventListener.WriteStallEnd()
+	require.Equal(t, stallDuration, p.GetMetrics().WriteStallDuration)
 	p.eventListener.DiskSlow(pebble.DiskSlowInfo{Duration: 1 * time.Second})
 	require.Equal(t, int64(1), p.writeStallCount)
 	require.Equal(t, int64(1), p.diskSlowCount)
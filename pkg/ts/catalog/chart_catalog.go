@@ -974,6 +974,46 @@ var charts = []sectionDescription{
 					"intentresolver.async.throttled",
 				},
 			},
+			{
+				Title: "Async Cleanup Tasks Running",
+				Metrics: []string{
+					"intentresolver.async.running",
+				},
+			},
+			{
+				Title: "Async Cleanups Abandoned",
+				Metrics: []string{
+					"intentresolver.async.gave_up",
+				},
+			},
+			{
+				Title: "Intents Resolved",
+				Metrics: []string{
+					"intentresolver.intents.resolved",
+					"intentresolver.intents.resolved_async",
+				},
+			},
+			{
+				Title: "Resolution Latency",
+				Metrics: []string{
+					"intentresolver.intents.latency",
+				},
+				AxisLabel: "Latency",
+			},
+			{
+				Title: "Pushes",
+				Metrics: []string{
+					"intentresolver.pushes.attempted",
+					"intentresolver.pushes.succeeded",
+					"intentresolver.pushes.failed",
+				},
+			},
+			{
+				Title: "Txn Records GC'ed",
+				Metrics: []string{
+					"intentresolver.finalized_txns.gced",
+				},
+			},
 			{
 				Title: "Overview",
 				Metrics: []string{
@@ -1571,6 +1611,15 @@ var charts = []sectionDescription{
 			},
 		},
 	},
+	{
+		Organization: [][]string{{ReplicationLayer, "Raft", "Transport"}},
+		Charts: []chartDescription{
+			{
+				Title:   "Dropped Outgoing Messages",
+				Metrics: []string{"raft.transport.sends_dropped"},
+			},
+		},
+	},
 	{
 		Organization: [][]string{{ReplicationLayer, "Ranges"}},
 		Charts: []chartDescription{
@@ -2553,6 +2602,10 @@ var charts = []sectionDescription{
 				Title:   "Stalls",
 				Metrics: []string{"storage.write-stalls"},
 			},
+			{
+				Title:   "Stall Duration",
+				Metrics: []string{"storage.write-stall-nanos"},
+			},
 		},
 	},
 	{
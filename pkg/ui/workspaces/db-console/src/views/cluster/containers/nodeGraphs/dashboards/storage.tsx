@@ -215,6 +215,20 @@ export default function(props: GraphDashboardProps) {
       </Axis>
     </LineGraph>,
 
+    <LineGraph
+      title="Write Stall Duration"
+      sources={storeSources}
+      tooltip={`The time spent in intentional write stalls per second ${tooltipSelection}.`}
+    >
+      <Axis units={AxisUnits.Duration} label="duration">
+        <Metric
+          name="cr.store.storage.write-stall-nanos"
+          title="Write Stall Duration"
+          nonNegativeRate
+        />
+      </Axis>
+    </LineGraph>,
+
     <LineGraph
       title="Time Series Writes"
       sources={nodeSources}